@@ -0,0 +1,243 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/JerryLinyx/FinGOAT/initializers"
+	"github.com/JerryLinyx/FinGOAT/models"
+	"github.com/gin-gonic/gin"
+)
+
+// articlesListResponse wraps a page of articles with pagination metadata.
+type articlesListResponse struct {
+	Articles   []models.Article `json:"articles"`
+	Page       int              `json:"page"`
+	PageSize   int              `json:"pageSize"`
+	Total      int64            `json:"total"`
+	TotalPages int              `json:"totalPages"`
+}
+
+// GetArticles lists articles with optional pagination, sorting, and
+// filtering by author or tag, e.g. GET /api/articles?page=2&pageSize=10&sort=-created_at&author=3&tag=go.
+func GetArticles(c *gin.Context) {
+	page, pageSize := parsePagination(c)
+
+	query := initializers.DB.Model(&models.Article{})
+
+	if author := c.Query("author"); author != "" {
+		query = query.Where("author_id = ?", author)
+	}
+	if tag := c.Query("tag"); tag != "" {
+		query = query.Where("tags LIKE ?", "%"+tag+"%")
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		errorJSON(c, http.StatusInternalServerError, "failed to count articles")
+		return
+	}
+
+	var articles []models.Article
+	if err := query.Order(parseSort(c.Query("sort"))).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&articles).Error; err != nil {
+		errorJSON(c, http.StatusInternalServerError, "failed to fetch articles")
+		return
+	}
+
+	totalPages := int((total + int64(pageSize) - 1) / int64(pageSize))
+
+	c.JSON(http.StatusOK, articlesListResponse{
+		Articles:   articles,
+		Page:       page,
+		PageSize:   pageSize,
+		Total:      total,
+		TotalPages: totalPages,
+	})
+}
+
+// GetArticlesByID returns a single article by ID.
+func GetArticlesByID(c *gin.Context) {
+	var article models.Article
+	if err := initializers.DB.First(&article, c.Param("id")).Error; err != nil {
+		errorJSON(c, http.StatusNotFound, "article not found")
+		return
+	}
+
+	c.JSON(http.StatusOK, article)
+}
+
+type createArticleInput struct {
+	Title string `json:"title" binding:"required"`
+	Body  string `json:"body" binding:"required"`
+	Tags  string `json:"tags"`
+}
+
+// CreateArticle creates an article owned by the authenticated user.
+func CreateArticle(c *gin.Context) {
+	var input createArticleInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		errorJSON(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	article := models.Article{
+		Title:    input.Title,
+		Body:     input.Body,
+		Tags:     input.Tags,
+		AuthorID: c.GetUint("userID"),
+	}
+
+	if err := initializers.DB.Create(&article).Error; err != nil {
+		errorJSON(c, http.StatusInternalServerError, "failed to create article")
+		return
+	}
+
+	c.JSON(http.StatusCreated, article)
+}
+
+type replaceArticleInput struct {
+	Title string `json:"title" binding:"required"`
+	Body  string `json:"body" binding:"required"`
+	Tags  string `json:"tags"`
+}
+
+// UpdateArticle fully replaces an article's editable fields (PUT semantics).
+func UpdateArticle(c *gin.Context) {
+	article, ok := loadOwnedArticle(c)
+	if !ok {
+		return
+	}
+
+	var input replaceArticleInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		errorJSON(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	article.Title = input.Title
+	article.Body = input.Body
+	article.Tags = input.Tags
+
+	if err := initializers.DB.Save(&article).Error; err != nil {
+		errorJSON(c, http.StatusInternalServerError, "failed to update article")
+		return
+	}
+
+	c.JSON(http.StatusOK, article)
+}
+
+type patchArticleInput struct {
+	Title *string `json:"title"`
+	Body  *string `json:"body"`
+	Tags  *string `json:"tags"`
+}
+
+// PatchArticle applies a JSON merge patch to title/body/tags, leaving any
+// omitted field untouched.
+func PatchArticle(c *gin.Context) {
+	article, ok := loadOwnedArticle(c)
+	if !ok {
+		return
+	}
+
+	var input patchArticleInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		errorJSON(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if input.Title != nil {
+		article.Title = *input.Title
+	}
+	if input.Body != nil {
+		article.Body = *input.Body
+	}
+	if input.Tags != nil {
+		article.Tags = *input.Tags
+	}
+
+	if err := initializers.DB.Save(&article).Error; err != nil {
+		errorJSON(c, http.StatusInternalServerError, "failed to update article")
+		return
+	}
+
+	c.JSON(http.StatusOK, article)
+}
+
+// DeleteArticle soft-deletes an article by setting its deleted_at column.
+func DeleteArticle(c *gin.Context) {
+	article, ok := loadOwnedArticle(c)
+	if !ok {
+		return
+	}
+
+	if err := initializers.DB.Delete(&article).Error; err != nil {
+		errorJSON(c, http.StatusInternalServerError, "failed to delete article")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// loadOwnedArticle fetches the article named by the :id param and verifies
+// the authenticated user is its author, writing the appropriate error
+// response and returning ok=false otherwise.
+func loadOwnedArticle(c *gin.Context) (models.Article, bool) {
+	var article models.Article
+	if err := initializers.DB.First(&article, c.Param("id")).Error; err != nil {
+		errorJSON(c, http.StatusNotFound, "article not found")
+		return models.Article{}, false
+	}
+
+	if article.AuthorID != c.GetUint("userID") {
+		errorJSON(c, http.StatusForbidden, "only the author can modify this article")
+		return models.Article{}, false
+	}
+
+	return article, true
+}
+
+func parsePagination(c *gin.Context) (page, pageSize int) {
+	page, err := strconv.Atoi(c.Query("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	pageSize, err = strconv.Atoi(c.Query("pageSize"))
+	if err != nil || pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	return page, pageSize
+}
+
+// sortableColumns whitelists the columns GetArticles may sort by, since
+// the column name is spliced directly into the ORDER BY clause below.
+var sortableColumns = map[string]bool{
+	"created_at": true,
+	"updated_at": true,
+	"title":      true,
+	"author_id":  true,
+}
+
+// parseSort turns a "?sort=" value into an ORDER BY clause. A leading "-"
+// requests descending order, e.g. "-created_at". Defaults to newest first.
+// Unrecognized columns are ignored in favor of the default to avoid
+// splicing unvalidated input into the query.
+func parseSort(sort string) string {
+	column, descending := strings.CutPrefix(sort, "-")
+
+	if !sortableColumns[column] {
+		return "created_at DESC"
+	}
+
+	if descending {
+		return column + " DESC"
+	}
+
+	return column + " ASC"
+}
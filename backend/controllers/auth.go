@@ -0,0 +1,103 @@
+package controllers
+
+import (
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/JerryLinyx/FinGOAT/initializers"
+	"github.com/JerryLinyx/FinGOAT/middlewares"
+	"github.com/JerryLinyx/FinGOAT/models"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type registerInput struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required,min=8"`
+}
+
+// Register creates a new user with a bcrypt-hashed password.
+func Register(c *gin.Context) {
+	var input registerInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		errorJSON(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
+	if err != nil {
+		errorJSON(c, http.StatusInternalServerError, "failed to hash password")
+		return
+	}
+
+	user := models.User{Email: input.Email, Password: string(hash)}
+	if err := initializers.DB.Create(&user).Error; err != nil {
+		errorJSON(c, http.StatusConflict, "email already registered")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"id": user.ID, "email": user.Email})
+}
+
+type loginInput struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required"`
+}
+
+// Login authenticates a user and, depending on the X-Auth-Mode header,
+// returns a JWT (the default) or sets a Redis-backed session cookie.
+func Login(c *gin.Context) {
+	var input loginInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		errorJSON(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var user models.User
+	if err := initializers.DB.First(&user, "email = ?", input.Email).Error; err != nil {
+		errorJSON(c, http.StatusUnauthorized, "invalid email or password")
+		return
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(input.Password)); err != nil {
+		errorJSON(c, http.StatusUnauthorized, "invalid email or password")
+		return
+	}
+
+	if middlewares.AuthMode(c.GetHeader("X-Auth-Mode")) == middlewares.AuthModeSession {
+		if err := middlewares.SetSessionUserID(c, user.ID); err != nil {
+			errorJSON(c, http.StatusInternalServerError, "failed to start session")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"id": user.ID, "email": user.Email})
+		return
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": user.ID,
+		"exp": time.Now().Add(24 * time.Hour).Unix(),
+	})
+
+	signed, err := token.SignedString([]byte(os.Getenv("JWT_SECRET")))
+	if err != nil {
+		errorJSON(c, http.StatusInternalServerError, "failed to sign token")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"token": signed})
+}
+
+// Logout invalidates the caller's session server-side. It is a no-op (but
+// still returns success) for JWT-authenticated requests, since a bearer
+// token cannot be revoked this way; clients should simply discard it.
+func Logout(c *gin.Context) {
+	if err := middlewares.ClearSession(c); err != nil {
+		errorJSON(c, http.StatusInternalServerError, "failed to clear session")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
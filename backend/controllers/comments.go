@@ -0,0 +1,93 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/JerryLinyx/FinGOAT/initializers"
+	"github.com/JerryLinyx/FinGOAT/models"
+	"github.com/gin-gonic/gin"
+)
+
+// GetComments lists comments on an article, oldest first.
+func GetComments(c *gin.Context) {
+	var article models.Article
+	if err := initializers.DB.First(&article, c.Param("id")).Error; err != nil {
+		errorJSON(c, http.StatusNotFound, "article not found")
+		return
+	}
+
+	var comments []models.Comment
+	if err := initializers.DB.Where("article_id = ?", article.ID).
+		Order("created_at ASC").
+		Find(&comments).Error; err != nil {
+		errorJSON(c, http.StatusInternalServerError, "failed to fetch comments")
+		return
+	}
+
+	c.JSON(http.StatusOK, comments)
+}
+
+type createCommentInput struct {
+	Body string `json:"body" binding:"required"`
+}
+
+// CreateComment adds a comment to an article on behalf of the authenticated user.
+func CreateComment(c *gin.Context) {
+	var article models.Article
+	if err := initializers.DB.First(&article, c.Param("id")).Error; err != nil {
+		errorJSON(c, http.StatusNotFound, "article not found")
+		return
+	}
+
+	var input createCommentInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		errorJSON(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	comment := models.Comment{
+		ArticleID: article.ID,
+		AuthorID:  c.GetUint("userID"),
+		Body:      input.Body,
+	}
+
+	if err := initializers.DB.Create(&comment).Error; err != nil {
+		errorJSON(c, http.StatusInternalServerError, "failed to create comment")
+		return
+	}
+
+	c.JSON(http.StatusCreated, comment)
+}
+
+// DeleteComment removes a comment nested under the article named by :id,
+// but only if the authenticated user is its author.
+func DeleteComment(c *gin.Context) {
+	var article models.Article
+	if err := initializers.DB.First(&article, c.Param("id")).Error; err != nil {
+		errorJSON(c, http.StatusNotFound, "article not found")
+		return
+	}
+
+	var comment models.Comment
+	if err := initializers.DB.First(&comment, c.Param("cid")).Error; err != nil {
+		errorJSON(c, http.StatusNotFound, "comment not found")
+		return
+	}
+
+	if comment.ArticleID != article.ID {
+		errorJSON(c, http.StatusNotFound, "comment not found")
+		return
+	}
+
+	if comment.AuthorID != c.GetUint("userID") {
+		errorJSON(c, http.StatusForbidden, "only the author can delete this comment")
+		return
+	}
+
+	if err := initializers.DB.Delete(&comment).Error; err != nil {
+		errorJSON(c, http.StatusInternalServerError, "failed to delete comment")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,44 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/JerryLinyx/FinGOAT/initializers"
+	"github.com/JerryLinyx/FinGOAT/models"
+	"github.com/gin-gonic/gin"
+)
+
+// LikeArticle records a like from the authenticated user, ignoring
+// duplicate likes from the same user on the same article.
+func LikeArticle(c *gin.Context) {
+	var article models.Article
+	if err := initializers.DB.First(&article, c.Param("id")).Error; err != nil {
+		errorJSON(c, http.StatusNotFound, "article not found")
+		return
+	}
+
+	like := models.ArticleLike{
+		ArticleID: article.ID,
+		UserID:    c.GetUint("userID"),
+	}
+
+	if err := initializers.DB.FirstOrCreate(&like, like).Error; err != nil {
+		errorJSON(c, http.StatusInternalServerError, "failed to like article")
+		return
+	}
+
+	c.JSON(http.StatusOK, like)
+}
+
+// GetArticleLikes returns the number of likes on an article.
+func GetArticleLikes(c *gin.Context) {
+	var count int64
+	if err := initializers.DB.Model(&models.ArticleLike{}).
+		Where("article_id = ?", c.Param("id")).
+		Count(&count).Error; err != nil {
+		errorJSON(c, http.StatusInternalServerError, "failed to count likes")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"likes": count})
+}
@@ -0,0 +1,16 @@
+package controllers
+
+import (
+	"github.com/JerryLinyx/FinGOAT/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+// errorJSON writes a JSON error body tagged with the request's ID (if
+// RequestID middleware is mounted) so it can be correlated with server
+// logs when a client reports an issue.
+func errorJSON(c *gin.Context, status int, message string) {
+	c.JSON(status, gin.H{
+		"error":     message,
+		"requestId": middlewares.GetRequestID(c),
+	})
+}
@@ -0,0 +1,7 @@
+package initializers
+
+import "gorm.io/gorm"
+
+// DB is the process-wide database handle, assigned during startup and
+// used by the controllers package for all persistence.
+var DB *gorm.DB
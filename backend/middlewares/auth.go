@@ -0,0 +1,119 @@
+package middlewares
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// AuthMode selects which credential type Auth() should accept.
+type AuthMode string
+
+const (
+	AuthModeJWT     AuthMode = "jwt"
+	AuthModeSession AuthMode = "session"
+)
+
+// authModeHeader lets a client request session-based auth instead of the
+// default JWT bearer token, e.g. from a first-party web app that prefers
+// cookies over storing a token in local storage.
+const authModeHeader = "X-Auth-Mode"
+
+// Auth accepts either a JWT bearer token or a Redis-backed session cookie,
+// selected per-request via the X-Auth-Mode header (defaulting to jwt), and
+// sets "userID" identically either way so controllers don't need to care
+// which credential type was used.
+func Auth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		mode := AuthMode(c.GetHeader(authModeHeader))
+		if mode == "" {
+			mode = AuthModeJWT
+		}
+
+		var (
+			userID uint
+			ok     bool
+		)
+
+		switch mode {
+		case AuthModeSession:
+			userID, ok = userIDFromSession(c)
+		default:
+			userID, ok = userIDFromJWT(c)
+		}
+
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid or missing credentials", "requestId": GetRequestID(c)})
+			return
+		}
+
+		c.Set("userID", userID)
+		c.Next()
+	}
+}
+
+func userIDFromJWT(c *gin.Context) (uint, bool) {
+	tokenString, err := c.Cookie("token")
+	if err != nil {
+		if header := c.GetHeader("Authorization"); len(header) > 7 && header[:7] == "Bearer " {
+			tokenString = header[7:]
+		}
+	}
+	if tokenString == "" {
+		return 0, false
+	}
+
+	token, err := jwt.Parse(tokenString, func(t *jwt.Token) (any, error) {
+		return []byte(os.Getenv("JWT_SECRET")), nil
+	}, jwt.WithValidMethods([]string{"HS256"}))
+	if err != nil || !token.Valid {
+		return 0, false
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return 0, false
+	}
+
+	if exp, ok := claims["exp"].(float64); ok && int64(exp) < time.Now().Unix() {
+		return 0, false
+	}
+
+	sub, ok := claims["sub"].(float64)
+	if !ok {
+		return 0, false
+	}
+
+	return uint(sub), true
+}
+
+func userIDFromSession(c *gin.Context) (uint, bool) {
+	sess := sessionFromContext(c)
+	if sess == nil {
+		return 0, false
+	}
+
+	raw := sess.Get(sessionUserIDKey)
+	if raw == nil {
+		return 0, false
+	}
+
+	switch v := raw.(type) {
+	case uint:
+		return v, true
+	case int:
+		return uint(v), true
+	case string:
+		id, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, false
+		}
+		return uint(id), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,117 @@
+package middlewares
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-contrib/cors"
+)
+
+// Environment variables read by NewCORS.
+const (
+	envAllowOrigins     = "CORS_ALLOW_ORIGINS"
+	envDenyOrigins      = "CORS_DENY_ORIGINS"
+	envAllowMethods     = "CORS_ALLOW_METHODS"
+	envAllowHeaders     = "CORS_ALLOW_HEADERS"
+	envExposeHeaders    = "CORS_EXPOSE_HEADERS"
+	envAllowCredentials = "CORS_ALLOW_CREDENTIALS"
+	envMaxAgeHours      = "CORS_MAX_AGE_HOURS"
+)
+
+// defaultAllowOrigin is used when CORS_ALLOW_ORIGINS is unset, preserving
+// the previous hardcoded local-dev behavior.
+const defaultAllowOrigin = "https://localhost:5173"
+
+// NewCORS builds a cors.Config from environment variables so the allowed
+// origins can differ between local, staging, and production deployments
+// without a code change. Origins support exact matches, comma-separated
+// lists, and wildcard subdomains (e.g. "*.fingoat.app"). Entries in
+// CORS_DENY_ORIGINS are rejected even if they would otherwise match an
+// allowed wildcard.
+func NewCORS() cors.Config {
+	allowed := splitAndTrim(getEnvOrDefault(envAllowOrigins, defaultAllowOrigin))
+	denied := splitAndTrim(os.Getenv(envDenyOrigins))
+
+	cfg := cors.Config{
+		AllowMethods:     splitAndTrim(getEnvOrDefault(envAllowMethods, "GET,POST,PUT,PATCH,DELETE,OPTIONS")),
+		AllowHeaders:     splitAndTrim(getEnvOrDefault(envAllowHeaders, "Origin,Content-Type,Authorization")),
+		ExposeHeaders:    splitAndTrim(getEnvOrDefault(envExposeHeaders, "Content-Length")),
+		AllowCredentials: getEnvBool(envAllowCredentials, true),
+		MaxAge:           time.Duration(getEnvInt(envMaxAgeHours, 12)) * time.Hour,
+		AllowOriginFunc: func(origin string) bool {
+			if matchesAny(origin, denied) {
+				return false
+			}
+			return matchesAny(origin, allowed)
+		},
+	}
+
+	log.Printf("[cors] allowed=%v denied=%v methods=%v credentials=%v maxAge=%s",
+		allowed, denied, cfg.AllowMethods, cfg.AllowCredentials, cfg.MaxAge)
+
+	return cfg
+}
+
+// matchesAny reports whether origin matches one of patterns. A pattern
+// beginning with "*." matches the origin's host and any of its subdomains
+// (e.g. "*.fingoat.app" matches "https://app.fingoat.app" and
+// "https://admin.fingoat.app"); any other pattern must match exactly.
+func matchesAny(origin string, patterns []string) bool {
+	host := strings.TrimPrefix(strings.TrimPrefix(origin, "https://"), "http://")
+
+	for _, pattern := range patterns {
+		if pattern == origin {
+			return true
+		}
+
+		if base, ok := strings.CutPrefix(pattern, "*."); ok {
+			if host == base || strings.HasSuffix(host, "."+base) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func splitAndTrim(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	parts := strings.Split(s, ",")
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+
+	return out
+}
+
+func getEnvOrDefault(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func getEnvBool(key string, fallback bool) bool {
+	v, err := strconv.ParseBool(os.Getenv(key))
+	if err != nil {
+		return fallback
+	}
+	return v
+}
+
+func getEnvInt(key string, fallback int) int {
+	v, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return fallback
+	}
+	return v
+}
@@ -0,0 +1,77 @@
+package middlewares
+
+import "testing"
+
+func TestMatchesAny(t *testing.T) {
+	cases := []struct {
+		name     string
+		origin   string
+		patterns []string
+		want     bool
+	}{
+		{
+			name:     "exact match",
+			origin:   "https://localhost:5173",
+			patterns: []string{"https://localhost:5173"},
+			want:     true,
+		},
+		{
+			name:     "exact mismatch",
+			origin:   "https://evil.example.com",
+			patterns: []string{"https://localhost:5173"},
+			want:     false,
+		},
+		{
+			name:     "wildcard subdomain matches",
+			origin:   "https://app.fingoat.app",
+			patterns: []string{"*.fingoat.app"},
+			want:     true,
+		},
+		{
+			name:     "wildcard subdomain matches a different subdomain",
+			origin:   "https://admin.fingoat.app",
+			patterns: []string{"*.fingoat.app"},
+			want:     true,
+		},
+		{
+			name:     "wildcard matches the bare apex too",
+			origin:   "https://fingoat.app",
+			patterns: []string{"*.fingoat.app"},
+			want:     true,
+		},
+		{
+			name:     "wildcard does not match an unrelated suffix",
+			origin:   "https://fingoat.app.evil.com",
+			patterns: []string{"*.fingoat.app"},
+			want:     false,
+		},
+		{
+			name:     "wildcard does not match a different domain",
+			origin:   "https://app.evil.com",
+			patterns: []string{"*.fingoat.app"},
+			want:     false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := matchesAny(tc.origin, tc.patterns); got != tc.want {
+				t.Errorf("matchesAny(%q, %v) = %v, want %v", tc.origin, tc.patterns, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSplitAndTrim(t *testing.T) {
+	got := splitAndTrim(" https://a.fingoat.app , https://b.fingoat.app ,,")
+	want := []string{"https://a.fingoat.app", "https://b.fingoat.app"}
+
+	if len(got) != len(want) {
+		t.Fatalf("splitAndTrim length = %d, want %d (%v)", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("splitAndTrim[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
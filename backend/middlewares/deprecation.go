@@ -0,0 +1,24 @@
+package middlewares
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Deprecated marks a route group as deprecated per RFC 8594: it sets
+// "Deprecation: true", a "Sunset" date after which the route may be
+// removed, and a "Link" header pointing at the successor route that
+// clients should migrate to.
+func Deprecated(successorPath string, sunset time.Time) gin.HandlerFunc {
+	sunsetHeader := sunset.UTC().Format(http.TimeFormat)
+
+	return func(c *gin.Context) {
+		c.Header("Deprecation", "true")
+		c.Header("Sunset", sunsetHeader)
+		c.Header("Link", fmt.Sprintf(`<%s>; rel="successor-version"`, successorPath))
+		c.Next()
+	}
+}
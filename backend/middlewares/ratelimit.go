@@ -0,0 +1,93 @@
+package middlewares
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/time/rate"
+)
+
+// RateLimitStore is the pluggable backend behind RateLimit. The default
+// in-memory implementation (NewMemoryStore) suits a single instance; a
+// Redis-backed implementation can be dropped in for multi-instance
+// deployments so limits are shared across them.
+type RateLimitStore interface {
+	// Allow reports whether a request identified by key is permitted
+	// under the given per-minute limit, the tokens left afterward, and
+	// if not allowed, how long the caller should wait before retrying.
+	Allow(key string, perMinute int) (allowed bool, remaining int, retryAfter time.Duration)
+}
+
+// memoryStore is the default RateLimitStore: one token-bucket limiter per
+// key, held in memory for the lifetime of the process.
+type memoryStore struct {
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+// NewMemoryStore builds an in-memory RateLimitStore.
+func NewMemoryStore() RateLimitStore {
+	return &memoryStore{limiters: make(map[string]*rate.Limiter)}
+}
+
+func (s *memoryStore) Allow(key string, perMinute int) (bool, int, time.Duration) {
+	s.mu.Lock()
+	limiter, ok := s.limiters[key]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(float64(perMinute))/60, perMinute)
+		s.limiters[key] = limiter
+	}
+	s.mu.Unlock()
+
+	reservation := limiter.Reserve()
+	if !reservation.OK() {
+		return false, 0, time.Minute
+	}
+
+	if delay := reservation.Delay(); delay > 0 {
+		reservation.Cancel()
+		return false, 0, delay
+	}
+
+	return true, int(limiter.Tokens()), 0
+}
+
+// RateLimit enforces perMinute requests per key under the given bucket
+// name, where the key is the authenticated userID if one is set on the
+// context, falling back to the client IP for unauthenticated endpoints
+// like /api/auth/login. The bucket name namespaces the limiter so the same
+// identity gets an independent budget per route instead of sharing one
+// bucket (and its perMinute) across every rate-limited route. On violation
+// it responds 429 with Retry-After and X-RateLimit-* headers.
+func RateLimit(bucket string, store RateLimitStore, perMinute int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := bucket + ":" + rateLimitKey(c)
+		allowed, remaining, retryAfter := store.Allow(key, perMinute)
+
+		c.Header("X-RateLimit-Limit", strconv.Itoa(perMinute))
+		c.Header("X-RateLimit-Remaining", strconv.Itoa(remaining))
+
+		if !allowed {
+			retrySeconds := int(retryAfter.Seconds()) + 1
+			c.Header("Retry-After", strconv.Itoa(retrySeconds))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+				"error":     fmt.Sprintf("rate limit exceeded, retry after %ds", retrySeconds),
+				"requestId": GetRequestID(c),
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func rateLimitKey(c *gin.Context) string {
+	if userID := c.GetUint("userID"); userID != 0 {
+		return "user:" + strconv.FormatUint(uint64(userID), 10)
+	}
+	return "ip:" + c.ClientIP()
+}
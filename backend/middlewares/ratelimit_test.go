@@ -0,0 +1,78 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestMemoryStoreAllow_EnforcesPerKeyLimit(t *testing.T) {
+	store := NewMemoryStore()
+
+	for i := 0; i < 5; i++ {
+		allowed, _, _ := store.Allow("login:ip:1.2.3.4", 5)
+		if !allowed {
+			t.Fatalf("call %d: expected allowed, got denied", i+1)
+		}
+	}
+
+	if allowed, _, _ := store.Allow("login:ip:1.2.3.4", 5); allowed {
+		t.Fatal("6th call within the same minute: expected denied, got allowed")
+	}
+}
+
+// TestMemoryStoreAllow_IndependentBuckets checks that the store keeps a
+// separate limiter per bucket-namespaced key (as RateLimit builds them), so
+// a request against a loosely-limited route (e.g. 60/min) does not loosen
+// the limit of a tightly-limited route (e.g. 5/min login) hit by the same
+// identity afterward.
+func TestMemoryStoreAllow_IndependentBuckets(t *testing.T) {
+	store := NewMemoryStore()
+
+	if allowed, _, _ := store.Allow("exchangeRates-get:ip:1.2.3.4", 60); !allowed {
+		t.Fatal("expected the exchangeRates bucket's first call to be allowed")
+	}
+
+	for i := 0; i < 5; i++ {
+		allowed, _, _ := store.Allow("login:ip:1.2.3.4", 5)
+		if !allowed {
+			t.Fatalf("login call %d: expected allowed, got denied", i+1)
+		}
+	}
+
+	if allowed, _, _ := store.Allow("login:ip:1.2.3.4", 5); allowed {
+		t.Fatal("6th login call: expected denied despite the same IP's exchangeRates traffic, got allowed")
+	}
+}
+
+func TestRateLimit_RespondsTooManyRequests(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	store := NewMemoryStore()
+	r := gin.New()
+	r.Use(RequestID())
+	r.GET("/ping", RateLimit("ping", store, 1), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	first := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/ping", nil)
+	r.ServeHTTP(first, req)
+	if first.Code != http.StatusOK {
+		t.Fatalf("first request: expected 200, got %d", first.Code)
+	}
+
+	second := httptest.NewRecorder()
+	r.ServeHTTP(second, req)
+	if second.Code != http.StatusTooManyRequests {
+		t.Fatalf("second request: expected 429, got %d", second.Code)
+	}
+	if second.Header().Get("Retry-After") == "" {
+		t.Error("expected Retry-After header on a rate-limited response")
+	}
+	if second.Header().Get("X-RateLimit-Limit") != "1" {
+		t.Errorf("expected X-RateLimit-Limit of 1, got %q", second.Header().Get("X-RateLimit-Limit"))
+	}
+}
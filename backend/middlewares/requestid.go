@@ -0,0 +1,38 @@
+package middlewares
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader is the header used to read and propagate the request ID.
+const RequestIDHeader = "X-Request-ID"
+
+// RequestIDContextKey is the gin context/log-formatter key the request ID
+// is stored under, exported so router.InitRouter's access-log formatter
+// can read it out of gin.LogFormatterParams.Keys.
+const RequestIDContextKey = "requestID"
+
+// RequestID reads X-Request-ID off the incoming request, generating a new
+// UUID if it's absent, stores it on the gin context, and echoes it back on
+// the response so it can be correlated across logs and error bodies.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if id == "" {
+			id = uuid.NewString()
+		}
+
+		c.Set(RequestIDContextKey, id)
+		c.Header(RequestIDHeader, id)
+		c.Next()
+	}
+}
+
+// GetRequestID returns the request ID stored by RequestID, or "" if the
+// middleware wasn't mounted.
+func GetRequestID(c *gin.Context) string {
+	id, _ := c.Get(RequestIDContextKey)
+	s, _ := id.(string)
+	return s
+}
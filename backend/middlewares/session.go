@@ -0,0 +1,52 @@
+package middlewares
+
+import (
+	"os"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-contrib/sessions/redis"
+	"github.com/gin-gonic/gin"
+)
+
+// sessionCookieName is the name of the session cookie set on login.
+const sessionCookieName = "fingoat_session"
+
+// sessionUserIDKey is the key under which the authenticated user's ID is
+// stored inside the session.
+const sessionUserIDKey = "userID"
+
+// NewRedisStore builds the Redis-backed session store used by
+// SessionMiddleware. Connection details come from REDIS_ADDR (host:port)
+// and REDIS_PASSWORD; the key that signs the session cookie comes from
+// SESSION_SECRET (the cookie is signed, not encrypted).
+func NewRedisStore() (redis.Store, error) {
+	return redis.NewStore(10, "tcp", os.Getenv("REDIS_ADDR"), "", os.Getenv("REDIS_PASSWORD"), []byte(os.Getenv("SESSION_SECRET")))
+}
+
+// SessionMiddleware mounts the Redis-backed session store on the gin
+// context under sessionCookieName so SetSessionUserID/ClearSession and the
+// session half of Auth() can read and write it.
+func SessionMiddleware(store redis.Store) gin.HandlerFunc {
+	return sessions.Sessions(sessionCookieName, store)
+}
+
+// SetSessionUserID starts a new session for userID and saves it, called by
+// controllers.Login when the client requests session-based auth.
+func SetSessionUserID(c *gin.Context, userID uint) error {
+	sess := sessions.Default(c)
+	sess.Set(sessionUserIDKey, userID)
+	return sess.Save()
+}
+
+// ClearSession invalidates the current session server-side, called by
+// controllers.Logout. Unlike a JWT, this immediately revokes the credential
+// instead of waiting for it to expire.
+func ClearSession(c *gin.Context) error {
+	sess := sessions.Default(c)
+	sess.Clear()
+	return sess.Save()
+}
+
+func sessionFromContext(c *gin.Context) sessions.Session {
+	return sessions.Default(c)
+}
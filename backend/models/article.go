@@ -0,0 +1,18 @@
+package models
+
+import "gorm.io/gorm"
+
+type Article struct {
+	gorm.Model
+	Title    string `gorm:"not null"`
+	Body     string `gorm:"not null"`
+	Tags     string
+	AuthorID uint `gorm:"not null"`
+	Author   User
+}
+
+type ArticleLike struct {
+	gorm.Model
+	ArticleID uint `gorm:"not null;uniqueIndex:idx_article_user_like"`
+	UserID    uint `gorm:"not null;uniqueIndex:idx_article_user_like"`
+}
@@ -0,0 +1,11 @@
+package models
+
+import "gorm.io/gorm"
+
+type Comment struct {
+	gorm.Model
+	ArticleID uint `gorm:"not null;index"`
+	AuthorID  uint `gorm:"not null"`
+	Author    User
+	Body      string `gorm:"not null"`
+}
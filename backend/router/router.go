@@ -1,48 +1,55 @@
 package router
 
 import (
+	"fmt"
+	"log"
 	"time"
 
-	"github.com/JerryLinyx/FinGOAT/controllers"
 	"github.com/JerryLinyx/FinGOAT/middlewares"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 )
 
-func InitRouter() *gin.Engine {
-	r := gin.Default()
-
-	r.Use(cors.New(cors.Config{
-		AllowOrigins:     []string{"https://localhost:5173"},
-		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization"},
-		ExposeHeaders:    []string{"Content-Length"},
-		AllowCredentials: true,
-		// AllowOriginFunc: func(origin string) bool {
-		// 	return origin == "https://github.com"
-		// },
-		MaxAge: 12 * time.Hour,
-	}))
-
-	auth := r.Group("/api/auth")
-	{
-		auth.POST("/login", controllers.Login)
-		auth.POST("/register", controllers.Register)
-	}
+// legacySunset is when the unversioned /api/... aliases stop being
+// served, one release out from the introduction of /api/v1.
+var legacySunset = time.Date(2026, time.October, 25, 0, 0, 0, 0, time.UTC)
+
+// logFormatter extends gin's default access log line with the request ID
+// set by middlewares.RequestID(), so a log line can be correlated with the
+// X-Request-ID returned to the client and echoed in error responses.
+func logFormatter(param gin.LogFormatterParams) string {
+	return fmt.Sprintf("[GIN] %s | %3d | %13v | %15s | requestId=%s | %-7s %s\n",
+		param.TimeStamp.Format("2006/01/02 - 15:04:05"),
+		param.StatusCode,
+		param.Latency,
+		param.ClientIP,
+		param.Keys[middlewares.RequestIDContextKey],
+		param.Method,
+		param.Path,
+	)
+}
 
-	api := r.Group("/api")
-	api.GET("/exchangeRates", controllers.GetExchangeRates)
-	api.Use(middlewares.AuthMiddleware())
-	{
-		api.POST("/exchangeRates", controllers.CreateExchangeRate)
+func InitRouter() *gin.Engine {
+	r := gin.New()
+	r.Use(gin.LoggerWithFormatter(logFormatter))
+	r.Use(gin.Recovery())
 
-		api.GET("/articles", controllers.GetArticles)
-		api.GET("/articles/:id", controllers.GetArticlesByID)
-		api.POST("/articles", controllers.CreateArticle)
+	r.Use(middlewares.RequestID())
+	r.Use(cors.New(middlewares.NewCORS()))
 
-		api.POST("/articles/:id/like", controllers.LikeArticle)
-		api.GET("/articles/:id/like", controllers.GetArticleLikes)
+	store, err := middlewares.NewRedisStore()
+	if err != nil {
+		log.Fatalf("failed to connect session store to redis: %v", err)
 	}
+	r.Use(middlewares.SessionMiddleware(store))
+
+	RegisterV1(r)
+
+	// Keep the pre-versioning paths working for one release so existing
+	// clients have time to migrate to /api/v1.
+	legacy := r.Group("/api")
+	legacy.Use(middlewares.Deprecated("/api/v1", legacySunset))
+	registerRoutes(legacy)
 
 	return r
 }
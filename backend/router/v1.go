@@ -0,0 +1,60 @@
+package router
+
+import (
+	"github.com/JerryLinyx/FinGOAT/controllers"
+	"github.com/JerryLinyx/FinGOAT/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+// rateLimitStore is shared across every registerRoutes call (v1 and the
+// deprecated /api alias) so a client can't double its quota by hitting
+// both; limiter keys are the bucket name plus user/IP, not the URL path.
+var rateLimitStore = middlewares.NewMemoryStore()
+
+// Per-route request-per-minute limits.
+const (
+	loginRateLimit        = 5
+	articlePostRateLimit  = 10
+	exchangeRatesGetLimit = 60
+)
+
+// RegisterV1 mounts every route under /api/v1, leaving room for a future
+// RegisterV2 to sit beside it without duplicating middleware wiring.
+func RegisterV1(r *gin.Engine) *gin.RouterGroup {
+	v1 := r.Group("/api/v1")
+	registerRoutes(v1)
+	return v1
+}
+
+// registerRoutes wires the full route tree onto base, which may be
+// "/api/v1" (the current version) or "/api" (kept as a deprecated alias).
+func registerRoutes(base *gin.RouterGroup) {
+	auth := base.Group("/auth")
+	{
+		auth.POST("/login", middlewares.RateLimit("login", rateLimitStore, loginRateLimit), controllers.Login)
+		auth.POST("/register", controllers.Register)
+		auth.POST("/logout", controllers.Logout)
+	}
+
+	base.GET("/exchangeRates", middlewares.RateLimit("exchangeRates-get", rateLimitStore, exchangeRatesGetLimit), controllers.GetExchangeRates)
+
+	protected := base.Group("")
+	protected.Use(middlewares.Auth())
+	{
+		protected.POST("/exchangeRates", controllers.CreateExchangeRate)
+
+		protected.GET("/articles", controllers.GetArticles)
+		protected.GET("/articles/:id", controllers.GetArticlesByID)
+		protected.POST("/articles", middlewares.RateLimit("article-post", rateLimitStore, articlePostRateLimit), controllers.CreateArticle)
+		protected.PUT("/articles/:id", controllers.UpdateArticle)
+		protected.PATCH("/articles/:id", controllers.PatchArticle)
+		protected.DELETE("/articles/:id", controllers.DeleteArticle)
+
+		protected.POST("/articles/:id/like", controllers.LikeArticle)
+		protected.GET("/articles/:id/like", controllers.GetArticleLikes)
+
+		protected.GET("/articles/:id/comments", controllers.GetComments)
+		protected.POST("/articles/:id/comments", controllers.CreateComment)
+		protected.DELETE("/articles/:id/comments/:cid", controllers.DeleteComment)
+	}
+}